@@ -0,0 +1,18 @@
+package cli
+
+import (
+	"github.com/urfave/cli"
+)
+
+// CreateKubergruntCli creates the kubergrunt CLI App, wiring up all of the top level commands exposed by this
+// package.
+func CreateKubergruntCli(version string) *cli.App {
+	app := cli.NewApp()
+	app.Name = "kubergrunt"
+	app.Usage = "A CLI tool to fill in the gaps between EKS, Helm, and Kubernetes."
+	app.Version = version
+	app.Commands = []cli.Command{
+		createK8SCommand(),
+	}
+	return app
+}
@@ -0,0 +1,18 @@
+package cli
+
+import (
+	"github.com/urfave/cli"
+)
+
+// createK8SCommand creates the `kubergrunt k8s` command group, which houses general purpose Kubernetes helper
+// subcommands that are not specific to any single kubergrunt workflow (EKS, TLS, Helm).
+func createK8SCommand() cli.Command {
+	return cli.Command{
+		Name:  "k8s",
+		Usage: "General purpose Kubernetes helper commands.",
+		Subcommands: []cli.Command{
+			createK8SCanICommand(),
+			createK8SGrantCommand(),
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/tnn-gruntwork-io/kubergrunt/kubectl"
+	"github.com/urfave/cli"
+)
+
+func createK8SCanICommand() cli.Command {
+	return cli.Command{
+		Name:  "can-i",
+		Usage: "Check whether the current kubeconfig context is permitted to perform a given action, or print the resolved rules for the current context.",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "kubeconfig",
+				Usage: "Path to the kubeconfig file to use. Defaults to the KUBECONFIG env var, or $HOME/.kube/config.",
+			},
+			cli.StringFlag{
+				Name:  "namespace",
+				Usage: "The namespace to check permissions against. Defaults to all namespaces.",
+			},
+			cli.StringFlag{
+				Name:  "verb",
+				Usage: "The verb to check (e.g. get, list, create). When omitted, prints the resolved rules for the context instead of checking a single permission.",
+			},
+			cli.StringFlag{
+				Name:  "group",
+				Usage: "The API group of the resource to check (e.g. '' for core, 'apps').",
+			},
+			cli.StringFlag{
+				Name:  "resource",
+				Usage: "The resource to check (e.g. pods, secrets).",
+			},
+		},
+		Action: runK8SCanICommand,
+	}
+}
+
+func runK8SCanICommand(cliContext *cli.Context) error {
+	kubectlOptions := &kubectl.KubectlOptions{
+		ConfigPath: cliContext.String("kubeconfig"),
+	}
+	namespace := cliContext.String("namespace")
+
+	verb := cliContext.String("verb")
+	if verb == "" {
+		return printResolvedRules(kubectlOptions, namespace)
+	}
+	return checkSinglePermission(kubectlOptions, verb, cliContext.String("group"), cliContext.String("resource"), namespace)
+}
+
+func checkSinglePermission(kubectlOptions *kubectl.KubectlOptions, verb string, group string, resource string, namespace string) error {
+	allowed, err := kubectl.CanI(kubectlOptions, verb, group, resource, namespace)
+	if err != nil {
+		return err
+	}
+	if allowed {
+		fmt.Printf("yes: %s is allowed to %s %s/%s in namespace %q\n", "current user", verb, group, resource, namespace)
+		return nil
+	}
+	return fmt.Errorf("no: current user is NOT allowed to %s %s/%s in namespace %q", verb, group, resource, namespace)
+}
+
+func printResolvedRules(kubectlOptions *kubectl.KubectlOptions, namespace string) error {
+	rules, err := kubectl.GetSelfSubjectRules(kubectlOptions, namespace)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		fmt.Printf(
+			"verbs=%v apiGroups=%v resources=%v resourceNames=%v\n",
+			rule.Verbs, rule.APIGroups, rule.Resources, rule.ResourceNames,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/tnn-gruntwork-io/kubergrunt/kubectl"
+	"github.com/tnn-gruntwork-io/kubergrunt/kubectl/rbactemplate"
+	"github.com/urfave/cli"
+)
+
+func createK8SGrantCommand() cli.Command {
+	return cli.Command{
+		Name:      "grant",
+		Usage:     "Grant a curated set of RBAC permissions to a ServiceAccount from a named template.",
+		ArgsUsage: "<template>",
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "kubeconfig",
+				Usage: "Path to the kubeconfig file to use. Defaults to the KUBECONFIG env var, or $HOME/.kube/config.",
+			},
+			cli.StringFlag{
+				Name:     "namespace",
+				Usage:    "The namespace to scope the generated Role and RoleBinding to.",
+				Required: true,
+			},
+			cli.StringFlag{
+				Name:     "service-account",
+				Usage:    "The name of the ServiceAccount to bind the generated Role to.",
+				Required: true,
+			},
+			cli.BoolFlag{
+				Name:  "yaml",
+				Usage: "Print the generated objects as YAML instead of applying them to the cluster.",
+			},
+		},
+		Action: runK8SGrantCommand,
+	}
+}
+
+func runK8SGrantCommand(cliContext *cli.Context) error {
+	if cliContext.NArg() != 1 {
+		return fmt.Errorf("grant expects exactly one argument: the name of the rbactemplate to grant")
+	}
+	templateName := rbactemplate.Name(cliContext.Args().Get(0))
+
+	bundle, err := rbactemplate.Generate(
+		templateName,
+		cliContext.String("namespace"),
+		cliContext.String("service-account"),
+		map[string]string{"gruntwork.io/rbactemplate": string(templateName)},
+	)
+	if err != nil {
+		return err
+	}
+
+	if cliContext.Bool("yaml") {
+		rendered, err := bundle.YAML()
+		if err != nil {
+			return err
+		}
+		fmt.Println(rendered)
+		return nil
+	}
+
+	kubectlOptions := &kubectl.KubectlOptions{ConfigPath: cliContext.String("kubeconfig")}
+	return bundle.Apply(kubectlOptions)
+}
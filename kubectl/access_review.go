@@ -0,0 +1,77 @@
+package kubectl
+
+import (
+	"context"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Permission represents a single Kubernetes RBAC permission that can be checked with CanI or CanIAll.
+type Permission struct {
+	Verb      string
+	Group     string
+	Resource  string
+	Namespace string
+}
+
+// CanI returns whether the identity associated with the given KubectlOptions is permitted to perform the given verb
+// on the given resource and group in the given namespace. This is a thin wrapper around the Kubernetes
+// SelfSubjectAccessReview API, and can be used as a preflight check before attempting an operation that requires
+// specific RBAC permissions.
+func CanI(kubectlOptions *KubectlOptions, verb string, group string, resource string, namespace string) (bool, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return false, err
+	}
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      verb,
+				Group:     group,
+				Resource:  resource,
+			},
+		},
+	}
+	result, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(context.Background(), review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return result.Status.Allowed, nil
+}
+
+// CanIAll checks every Permission in the given list with CanI, and returns whether all of them are allowed. When one
+// or more permissions are denied, the returned slice contains the denied Permissions so that callers can report
+// exactly which permissions are missing, instead of failing partway through an operation with an opaque error.
+func CanIAll(kubectlOptions *KubectlOptions, permissions []Permission) (bool, []Permission, error) {
+	var denied []Permission
+	for _, permission := range permissions {
+		allowed, err := CanI(kubectlOptions, permission.Verb, permission.Group, permission.Resource, permission.Namespace)
+		if err != nil {
+			return false, nil, err
+		}
+		if !allowed {
+			denied = append(denied, permission)
+		}
+	}
+	return len(denied) == 0, denied, nil
+}
+
+// GetSelfSubjectRules returns the list of resource rules that the identity associated with the given KubectlOptions
+// is allowed to perform in the given namespace, using the Kubernetes SelfSubjectRulesReview API. This is useful for
+// printing a human readable summary of the effective permissions for the current kubeconfig context.
+func GetSelfSubjectRules(kubectlOptions *KubectlOptions, namespace string) ([]authorizationv1.ResourceRule, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return nil, err
+	}
+	review := &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: namespace},
+	}
+	result, err := client.AuthorizationV1().SelfSubjectRulesReviews().Create(context.Background(), review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return result.Status.ResourceRules, nil
+}
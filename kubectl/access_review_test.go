@@ -0,0 +1,37 @@
+package kubectl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test that CanI returns true for a permission that the test identity definitely has (listing namespaces is granted
+// to any authenticated user in the default test cluster RBAC setup).
+func TestCanIAllowed(t *testing.T) {
+	t.Parallel()
+
+	_, kubectlOptions := GetKubectlOptions(t)
+
+	allowed, err := CanI(kubectlOptions, "list", "", "namespaces", "")
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+// Test that CanIAll reports the specific permission that is denied when checking a batch that includes a resource
+// that does not exist.
+func TestCanIAllReportsDeniedPermissions(t *testing.T) {
+	t.Parallel()
+
+	_, kubectlOptions := GetKubectlOptions(t)
+
+	deniedPermission := Permission{Verb: "get", Group: "bogus.example.com", Resource: "doesnotexist", Namespace: ""}
+	permissions := []Permission{
+		{Verb: "list", Group: "", Resource: "namespaces", Namespace: ""},
+		deniedPermission,
+	}
+	allAllowed, denied, err := CanIAll(kubectlOptions, permissions)
+	require.NoError(t, err)
+	require.False(t, allAllowed)
+	require.Equal(t, []Permission{deniedPermission}, denied)
+}
@@ -0,0 +1,71 @@
+package kubectl
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tnn-gruntwork-io/terratest/modules/k8s"
+	"github.com/tnn-gruntwork-io/terratest/modules/random"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that ApplyRole can both create a new Role and reconcile an existing one without erroring.
+func TestApplyRoleIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	ttKubectlOptions, kubectlOptions := GetKubectlOptions(t)
+
+	namespace := strings.ToLower(random.UniqueId())
+	k8s.CreateNamespace(t, ttKubectlOptions, namespace)
+	defer k8s.DeleteNamespace(t, ttKubectlOptions, namespace)
+
+	roleName := getTestRoleName(namespace)
+	rules := []rbacv1.PolicyRule{
+		rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}},
+	}
+	role := PrepareRole(namespace, roleName, map[string]string{}, map[string]string{}, rules)
+	require.NoError(t, ApplyRole(kubectlOptions, role))
+
+	// Applying again with an extra rule should reconcile the existing Role rather than error.
+	role.Rules = append(role.Rules, rbacv1.PolicyRule{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"pods"}})
+	require.NoError(t, ApplyRole(kubectlOptions, role))
+
+	fetched, err := GetRole(kubectlOptions, namespace, roleName)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(fetched.Rules))
+}
+
+// Test that EnsureSubjectInRoleBinding adds a subject exactly once, even when called repeatedly.
+func TestEnsureSubjectInRoleBindingIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	ttKubectlOptions, kubectlOptions := GetKubectlOptions(t)
+
+	namespace := strings.ToLower(random.UniqueId())
+	k8s.CreateNamespace(t, ttKubectlOptions, namespace)
+	defer k8s.DeleteNamespace(t, ttKubectlOptions, namespace)
+
+	roleBindingName := getTestRoleBindingName(namespace)
+	roleBinding := PrepareRoleBinding(
+		namespace,
+		roleBindingName,
+		map[string]string{},
+		map[string]string{},
+		[]rbacv1.Subject{},
+		rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "Role", Name: getTestRoleName(namespace)},
+	)
+	require.NoError(t, ApplyRoleBinding(kubectlOptions, roleBinding))
+
+	subject := rbacv1.Subject{Kind: "User", Name: fmt.Sprintf("test-user-%s", random.UniqueId())}
+	require.NoError(t, EnsureSubjectInRoleBinding(kubectlOptions, roleBindingName, namespace, subject))
+	require.NoError(t, EnsureSubjectInRoleBinding(kubectlOptions, roleBindingName, namespace, subject))
+
+	fetched, err := GetRoleBinding(kubectlOptions, namespace, roleBindingName)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(fetched.Subjects))
+	assert.Equal(t, subject, fetched.Subjects[0])
+}
@@ -0,0 +1,128 @@
+package kubectl
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// PrepareClusterRole will create a Kubernetes ClusterRole struct that can be used with CreateClusterRole to create a
+// new cluster-scoped ClusterRole resource on the Kubernetes cluster.
+func PrepareClusterRole(
+	name string,
+	labels map[string]string,
+	annotations map[string]string,
+	rules []rbacv1.PolicyRule,
+) *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Rules: rules,
+	}
+}
+
+// CreateClusterRole will create the given ClusterRole resource on the Kubernetes cluster.
+func CreateClusterRole(kubectlOptions *KubectlOptions, clusterRole *rbacv1.ClusterRole) error {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return err
+	}
+	_, err = client.RbacV1().ClusterRoles().Create(context.Background(), clusterRole, metav1.CreateOptions{})
+	return err
+}
+
+// GetClusterRole will return the ClusterRole resource identified by the given name.
+func GetClusterRole(kubectlOptions *KubectlOptions, name string) (*rbacv1.ClusterRole, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return nil, err
+	}
+	return client.RbacV1().ClusterRoles().Get(context.Background(), name, metav1.GetOptions{})
+}
+
+// DeleteClusterRole will delete the ClusterRole resource identified by the given name.
+func DeleteClusterRole(kubectlOptions *KubectlOptions, name string) error {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return err
+	}
+	return client.RbacV1().ClusterRoles().Delete(context.Background(), name, metav1.DeleteOptions{})
+}
+
+// ListClusterRoles will return the list of ClusterRole resources that match the provided list options.
+func ListClusterRoles(kubectlOptions *KubectlOptions, listOptions metav1.ListOptions) ([]rbacv1.ClusterRole, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return nil, err
+	}
+	clusterRoleList, err := client.RbacV1().ClusterRoles().List(context.Background(), listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return clusterRoleList.Items, nil
+}
+
+// PrepareClusterRoleBinding will create a Kubernetes ClusterRoleBinding struct that can be used with
+// CreateClusterRoleBinding to create a new cluster-scoped ClusterRoleBinding resource on the Kubernetes cluster.
+func PrepareClusterRoleBinding(
+	name string,
+	labels map[string]string,
+	annotations map[string]string,
+	subjects []rbacv1.Subject,
+	roleRef rbacv1.RoleRef,
+) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Subjects: subjects,
+		RoleRef:  roleRef,
+	}
+}
+
+// CreateClusterRoleBinding will create the given ClusterRoleBinding resource on the Kubernetes cluster.
+func CreateClusterRoleBinding(kubectlOptions *KubectlOptions, clusterRoleBinding *rbacv1.ClusterRoleBinding) error {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return err
+	}
+	_, err = client.RbacV1().ClusterRoleBindings().Create(context.Background(), clusterRoleBinding, metav1.CreateOptions{})
+	return err
+}
+
+// GetClusterRoleBinding will return the ClusterRoleBinding resource identified by the given name.
+func GetClusterRoleBinding(kubectlOptions *KubectlOptions, name string) (*rbacv1.ClusterRoleBinding, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return nil, err
+	}
+	return client.RbacV1().ClusterRoleBindings().Get(context.Background(), name, metav1.GetOptions{})
+}
+
+// DeleteClusterRoleBinding will delete the ClusterRoleBinding resource identified by the given name.
+func DeleteClusterRoleBinding(kubectlOptions *KubectlOptions, name string) error {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return err
+	}
+	return client.RbacV1().ClusterRoleBindings().Delete(context.Background(), name, metav1.DeleteOptions{})
+}
+
+// ListClusterRoleBindings will return the list of ClusterRoleBinding resources that match the provided list options.
+func ListClusterRoleBindings(kubectlOptions *KubectlOptions, listOptions metav1.ListOptions) ([]rbacv1.ClusterRoleBinding, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return nil, err
+	}
+	clusterRoleBindingList, err := client.RbacV1().ClusterRoleBindings().List(context.Background(), listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return clusterRoleBindingList.Items, nil
+}
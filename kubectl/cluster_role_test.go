@@ -0,0 +1,93 @@
+package kubectl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/tnn-gruntwork-io/terratest/modules/random"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that we can create a cluster role with read permissions on pods, get it back, and delete it
+func TestCreateGetDeleteClusterRole(t *testing.T) {
+	t.Parallel()
+
+	_, kubectlOptions := GetKubectlOptions(t)
+
+	testRules := []rbacv1.PolicyRule{
+		rbacv1.PolicyRule{
+			Verbs:     []string{"get", "list"},
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+		},
+	}
+	clusterRoleName := getTestClusterRoleName()
+	clusterRole := PrepareClusterRole(
+		clusterRoleName,
+		getTestLabels(),
+		map[string]string{},
+		testRules,
+	)
+	require.NoError(t, CreateClusterRole(kubectlOptions, clusterRole))
+	defer DeleteClusterRole(kubectlOptions, clusterRoleName)
+
+	fetched, err := GetClusterRole(kubectlOptions, clusterRoleName)
+	require.NoError(t, err)
+	assert.Equal(t, fetched.Name, clusterRoleName)
+	assert.Equal(t, fetched.Rules[0], testRules[0])
+
+	require.NoError(t, DeleteClusterRole(kubectlOptions, clusterRoleName))
+	_, err = GetClusterRole(kubectlOptions, clusterRoleName)
+	require.Error(t, err)
+}
+
+// Test that we can create a cluster role and cluster role binding, and find the binding with ListClusterRoleBindings
+func TestCreateAndListClusterRoleBinding(t *testing.T) {
+	t.Parallel()
+
+	_, kubectlOptions := GetKubectlOptions(t)
+
+	clusterRoleName := getTestClusterRoleName()
+	clusterRole := PrepareClusterRole(clusterRoleName, map[string]string{}, map[string]string{}, []rbacv1.PolicyRule{})
+	require.NoError(t, CreateClusterRole(kubectlOptions, clusterRole))
+	defer DeleteClusterRole(kubectlOptions, clusterRoleName)
+
+	subjects := []rbacv1.Subject{
+		rbacv1.Subject{
+			Kind: "User",
+			Name: fmt.Sprintf("test-user-%s", random.UniqueId()),
+		},
+	}
+	roleRef := rbacv1.RoleRef{
+		APIGroup: "rbac.authorization.k8s.io",
+		Kind:     "ClusterRole",
+		Name:     clusterRoleName,
+	}
+	clusterRoleBindingName := getTestClusterRoleBindingName()
+	clusterRoleBinding := PrepareClusterRoleBinding(
+		clusterRoleBindingName,
+		getTestLabels(),
+		map[string]string{},
+		subjects,
+		roleRef,
+	)
+	require.NoError(t, CreateClusterRoleBinding(kubectlOptions, clusterRoleBinding))
+	defer DeleteClusterRoleBinding(kubectlOptions, clusterRoleBindingName)
+
+	labels := LabelsToListOptions(getTestLabels())
+	clusterRoleBindings, err := ListClusterRoleBindings(kubectlOptions, labels)
+	require.NoError(t, err)
+	assert.NotEmpty(t, clusterRoleBindings)
+	assert.Equal(t, clusterRoleBindingName, clusterRoleBindings[0].Name)
+}
+
+func getTestClusterRoleName() string {
+	return fmt.Sprintf("kubergrunt-test-cluster-role-%s", random.UniqueId())
+}
+
+func getTestClusterRoleBindingName() string {
+	return fmt.Sprintf("kubergrunt-test-cluster-role-binding-%s", random.UniqueId())
+}
@@ -0,0 +1,35 @@
+package kubectl
+
+import (
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// KubectlOptions represents common options necessary to specify for all Kubernetes Kubectl calls
+type KubectlOptions struct {
+	ContextName string
+	ConfigPath  string
+	Namespace   string
+}
+
+// GetKubernetesClientFromOptions returns a Kubernetes API client given a KubectlOptions object that can be used to
+// make calls against the configured cluster.
+func GetKubernetesClientFromOptions(kubectlOptions *KubectlOptions) (*kubernetes.Clientset, error) {
+	config, err := loadApiClientConfig(kubectlOptions.ConfigPath, kubectlOptions.ContextName)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// loadApiClientConfig loads the Kubernetes client config from the given kubeconfig path and context, falling back
+// to the default client loading rules (KUBECONFIG env var, then ~/.kube/config) when configPath is empty.
+func loadApiClientConfig(configPath string, contextName string) (*rest.Config, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if configPath != "" {
+		loadingRules.ExplicitPath = configPath
+	}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: contextName}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+}
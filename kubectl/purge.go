@@ -0,0 +1,179 @@
+package kubectl
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PurgeableKind enumerates the resource kinds that PurgeByLabels knows how to list and delete.
+type PurgeableKind string
+
+const (
+	KindRole                  PurgeableKind = "Role"
+	KindRoleBinding           PurgeableKind = "RoleBinding"
+	KindClusterRole           PurgeableKind = "ClusterRole"
+	KindClusterRoleBinding    PurgeableKind = "ClusterRoleBinding"
+	KindServiceAccount        PurgeableKind = "ServiceAccount"
+	KindJob                   PurgeableKind = "Job"
+	KindSecret                PurgeableKind = "Secret"
+	KindPersistentVolumeClaim PurgeableKind = "PersistentVolumeClaim"
+)
+
+// PurgedResource identifies a single resource that PurgeByLabels found (and, outside of dry-run mode, deleted).
+type PurgedResource struct {
+	Kind      PurgeableKind
+	Name      string
+	Namespace string
+}
+
+// PurgeByLabels finds every resource of the given kinds, across all namespaces, that matches the given label
+// selector. When dryRun is true, it returns the full list of resources that would be deleted. When dryRun is false,
+// it deletes every matched resource and returns only the ones actually deleted, so that callers like
+// `kubergrunt helm undeploy` or `kubergrunt eks cleanup` can print what was removed without having to track
+// individual resource names themselves. If a deletion fails partway through, the returned slice covers only the
+// resources deleted before the failure, not the full match set.
+//
+// Cluster-scoped kinds (ClusterRole, ClusterRoleBinding) ignore namespaces entirely; namespaced kinds are matched
+// across every namespace in the cluster.
+func PurgeByLabels(kubectlOptions *KubectlOptions, labels map[string]string, dryRun bool, kinds ...PurgeableKind) ([]PurgedResource, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return nil, err
+	}
+	listOptions := LabelsToListOptions(labels)
+
+	var matches []PurgedResource
+	for _, kind := range kinds {
+		found, err := listPurgeableResources(client, kind, listOptions)
+		if err != nil {
+			return matches, err
+		}
+		matches = append(matches, found...)
+	}
+
+	if dryRun {
+		return matches, nil
+	}
+
+	deleted := make([]PurgedResource, 0, len(matches))
+	for _, resource := range matches {
+		if err := deletePurgeableResource(client, resource); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, resource)
+	}
+	return deleted, nil
+}
+
+func listPurgeableResources(client *kubernetes.Clientset, kind PurgeableKind, listOptions metav1.ListOptions) ([]PurgedResource, error) {
+	ctx := context.Background()
+	var resources []PurgedResource
+
+	switch kind {
+	case KindRole:
+		list, err := client.RbacV1().Roles(metav1.NamespaceAll).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			resources = append(resources, PurgedResource{Kind: kind, Name: item.Name, Namespace: item.Namespace})
+		}
+	case KindRoleBinding:
+		list, err := client.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			resources = append(resources, PurgedResource{Kind: kind, Name: item.Name, Namespace: item.Namespace})
+		}
+	case KindClusterRole:
+		list, err := client.RbacV1().ClusterRoles().List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			resources = append(resources, PurgedResource{Kind: kind, Name: item.Name})
+		}
+	case KindClusterRoleBinding:
+		list, err := client.RbacV1().ClusterRoleBindings().List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			resources = append(resources, PurgedResource{Kind: kind, Name: item.Name})
+		}
+	case KindServiceAccount:
+		list, err := client.CoreV1().ServiceAccounts(metav1.NamespaceAll).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			resources = append(resources, PurgedResource{Kind: kind, Name: item.Name, Namespace: item.Namespace})
+		}
+	case KindJob:
+		list, err := client.BatchV1().Jobs(metav1.NamespaceAll).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			resources = append(resources, PurgedResource{Kind: kind, Name: item.Name, Namespace: item.Namespace})
+		}
+	case KindSecret:
+		list, err := client.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			resources = append(resources, PurgedResource{Kind: kind, Name: item.Name, Namespace: item.Namespace})
+		}
+	case KindPersistentVolumeClaim:
+		list, err := client.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list.Items {
+			resources = append(resources, PurgedResource{Kind: kind, Name: item.Name, Namespace: item.Namespace})
+		}
+	default:
+		return nil, UnknownPurgeableKindErr{Kind: kind}
+	}
+	return resources, nil
+}
+
+func deletePurgeableResource(client *kubernetes.Clientset, resource PurgedResource) error {
+	ctx := context.Background()
+	deleteOptions := metav1.DeleteOptions{}
+
+	switch resource.Kind {
+	case KindRole:
+		return client.RbacV1().Roles(resource.Namespace).Delete(ctx, resource.Name, deleteOptions)
+	case KindRoleBinding:
+		return client.RbacV1().RoleBindings(resource.Namespace).Delete(ctx, resource.Name, deleteOptions)
+	case KindClusterRole:
+		return client.RbacV1().ClusterRoles().Delete(ctx, resource.Name, deleteOptions)
+	case KindClusterRoleBinding:
+		return client.RbacV1().ClusterRoleBindings().Delete(ctx, resource.Name, deleteOptions)
+	case KindServiceAccount:
+		return client.CoreV1().ServiceAccounts(resource.Namespace).Delete(ctx, resource.Name, deleteOptions)
+	case KindJob:
+		return client.BatchV1().Jobs(resource.Namespace).Delete(ctx, resource.Name, deleteOptions)
+	case KindSecret:
+		return client.CoreV1().Secrets(resource.Namespace).Delete(ctx, resource.Name, deleteOptions)
+	case KindPersistentVolumeClaim:
+		return client.CoreV1().PersistentVolumeClaims(resource.Namespace).Delete(ctx, resource.Name, deleteOptions)
+	default:
+		return UnknownPurgeableKindErr{Kind: resource.Kind}
+	}
+}
+
+// UnknownPurgeableKindErr is returned by PurgeByLabels when asked to operate on a PurgeableKind it does not know how
+// to list or delete.
+type UnknownPurgeableKindErr struct {
+	Kind PurgeableKind
+}
+
+func (err UnknownPurgeableKindErr) Error() string {
+	return "unknown purgeable kind: " + string(err.Kind)
+}
@@ -0,0 +1,45 @@
+package kubectl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tnn-gruntwork-io/terratest/modules/k8s"
+	"github.com/tnn-gruntwork-io/terratest/modules/random"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that PurgeByLabels finds matching resources but leaves them alone when dryRun is true, and deletes them when
+// dryRun is false.
+func TestPurgeByLabelsDryRunThenForce(t *testing.T) {
+	t.Parallel()
+
+	ttKubectlOptions, kubectlOptions := GetKubectlOptions(t)
+
+	namespace := strings.ToLower(random.UniqueId())
+	k8s.CreateNamespace(t, ttKubectlOptions, namespace)
+	defer k8s.DeleteNamespace(t, ttKubectlOptions, namespace)
+
+	roleName := getTestRoleName(namespace)
+	role := PrepareRole(namespace, roleName, getTestLabels(), map[string]string{}, []rbacv1.PolicyRule{})
+	require.NoError(t, CreateRole(kubectlOptions, role))
+
+	matches, err := PurgeByLabels(kubectlOptions, getTestLabels(), true, KindRole)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, roleName, matches[0].Name)
+
+	// Dry run must not have deleted anything.
+	_, err = GetRole(kubectlOptions, namespace, roleName)
+	require.NoError(t, err)
+
+	matches, err = PurgeByLabels(kubectlOptions, getTestLabels(), false, KindRole)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+
+	_, err = GetRole(kubectlOptions, namespace, roleName)
+	require.Error(t, err)
+}
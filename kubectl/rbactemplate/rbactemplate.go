@@ -0,0 +1,131 @@
+// Package rbactemplate provides a curated catalog of common RBAC bundles (a Role, RoleBinding, and ServiceAccount)
+// so that operators can hand out scoped permissions without hand-crafting PolicyRules for every recurring use case.
+package rbactemplate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tnn-gruntwork-io/kubergrunt/kubectl"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Name identifies one of the curated RBAC templates known to this package.
+type Name string
+
+const (
+	// PodReader grants read-only access to Pods in a namespace.
+	PodReader Name = "pod-reader"
+	// HelmDeployer grants the permissions Helm needs to deploy and manage releases in a namespace.
+	HelmDeployer Name = "helm-deployer"
+	// TillerNamespaceAdmin grants full admin access within a single namespace, mirroring the scope classic Tiller
+	// was typically restricted to when run with namespace-scoped RBAC.
+	TillerNamespaceAdmin Name = "tiller-namespace-admin"
+	// PortForwarder grants the permissions needed to open a kubectl port-forward session against Pods.
+	PortForwarder Name = "port-forwarder"
+	// SecretReader grants read-only access to Secrets in a namespace.
+	SecretReader Name = "secret-reader"
+)
+
+// Bundle is the set of RBAC objects produced by materializing a template: a ServiceAccount, a Role scoped to the
+// template's namespace, and a RoleBinding tying the two together.
+type Bundle struct {
+	ServiceAccount *corev1.ServiceAccount
+	Role           *rbacv1.Role
+	RoleBinding    *rbacv1.RoleBinding
+}
+
+// Generate builds the typed RBAC objects for the given template name, namespace, and ServiceAccount name. The
+// returned Bundle can either be materialized onto a cluster with Apply, or rendered to YAML for GitOps workflows.
+func Generate(name Name, namespace string, serviceAccountName string, labels map[string]string) (*Bundle, error) {
+	rules, err := rulesFor(name)
+	if err != nil {
+		return nil, err
+	}
+
+	roleName := fmt.Sprintf("%s-%s", serviceAccountName, name)
+	serviceAccount := &corev1.ServiceAccount{
+		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName, Namespace: namespace, Labels: labels},
+	}
+	role := kubectl.PrepareRole(namespace, roleName, labels, map[string]string{}, rules)
+	role.TypeMeta = metav1.TypeMeta{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1"}
+	roleBinding := kubectl.PrepareRoleBinding(
+		namespace,
+		roleName,
+		labels,
+		map[string]string{},
+		[]rbacv1.Subject{{Kind: "ServiceAccount", Name: serviceAccountName, Namespace: namespace}},
+		rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "Role", Name: roleName},
+	)
+	roleBinding.TypeMeta = metav1.TypeMeta{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1"}
+
+	return &Bundle{ServiceAccount: serviceAccount, Role: role, RoleBinding: roleBinding}, nil
+}
+
+// rulesFor returns the PolicyRules that back the given named template.
+func rulesFor(name Name) ([]rbacv1.PolicyRule, error) {
+	switch name {
+	case PodReader:
+		return []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list", "watch"}},
+		}, nil
+	case HelmDeployer:
+		return []rbacv1.PolicyRule{
+			{APIGroups: []string{"apps"}, Resources: []string{"deployments", "statefulsets", "daemonsets"}, Verbs: []string{"get", "list", "watch", "create", "update", "patch", "delete"}},
+			{APIGroups: []string{""}, Resources: []string{"services", "configmaps", "secrets"}, Verbs: []string{"get", "list", "watch", "create", "update", "patch", "delete"}},
+		}, nil
+	case TillerNamespaceAdmin:
+		return []rbacv1.PolicyRule{
+			{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+		}, nil
+	case PortForwarder:
+		return []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}},
+			{APIGroups: []string{""}, Resources: []string{"pods/portforward"}, Verbs: []string{"create"}},
+		}, nil
+	case SecretReader:
+		return []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "list"}},
+		}, nil
+	default:
+		return nil, UnknownTemplateErr{Name: name}
+	}
+}
+
+// Apply materializes the Bundle's Role and RoleBinding onto the cluster using the existing CreateRole and
+// CreateRoleBinding primitives in the kubectl package. It does not create the ServiceAccount: callers typically
+// already have one (provisioned by Helm, kubergrunt eks, or the cluster's default service account).
+func (bundle *Bundle) Apply(kubectlOptions *kubectl.KubectlOptions) error {
+	if err := kubectl.CreateRole(kubectlOptions, bundle.Role); err != nil {
+		return err
+	}
+	return kubectl.CreateRoleBinding(kubectlOptions, bundle.RoleBinding)
+}
+
+// YAML renders the Bundle as a multi-document YAML string (ServiceAccount, Role, RoleBinding) suitable for checking
+// into a GitOps repository instead of applying directly.
+func (bundle *Bundle) YAML() (string, error) {
+	documents := []interface{}{bundle.ServiceAccount, bundle.Role, bundle.RoleBinding}
+	rendered := make([]string, 0, len(documents))
+	for _, document := range documents {
+		data, err := yaml.Marshal(document)
+		if err != nil {
+			return "", err
+		}
+		rendered = append(rendered, string(data))
+	}
+	return strings.Join(rendered, "---\n"), nil
+}
+
+// UnknownTemplateErr is returned by Generate when asked for a template Name that is not in the catalog.
+type UnknownTemplateErr struct {
+	Name Name
+}
+
+func (err UnknownTemplateErr) Error() string {
+	return fmt.Sprintf("unknown rbactemplate: %s", err.Name)
+}
@@ -0,0 +1,44 @@
+package rbactemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that every template in the catalog generates a valid, internally consistent Bundle.
+func TestGenerateAllTemplates(t *testing.T) {
+	t.Parallel()
+
+	templates := []Name{PodReader, HelmDeployer, TillerNamespaceAdmin, PortForwarder, SecretReader}
+	for _, name := range templates {
+		bundle, err := Generate(name, "test-namespace", "test-service-account", map[string]string{"gruntwork.io/template": string(name)})
+		require.NoError(t, err)
+		assert.NotEmpty(t, bundle.Role.Rules)
+		assert.Equal(t, "test-service-account", bundle.ServiceAccount.Name)
+		assert.Equal(t, bundle.Role.Name, bundle.RoleBinding.RoleRef.Name)
+		assert.Equal(t, "test-service-account", bundle.RoleBinding.Subjects[0].Name)
+	}
+}
+
+// Test that Generate rejects a template name that is not in the catalog.
+func TestGenerateUnknownTemplate(t *testing.T) {
+	t.Parallel()
+
+	_, err := Generate(Name("does-not-exist"), "test-namespace", "test-service-account", map[string]string{})
+	require.Error(t, err)
+}
+
+// Test that YAML renders all three objects as a multi-document YAML string.
+func TestBundleYAML(t *testing.T) {
+	t.Parallel()
+
+	bundle, err := Generate(PodReader, "test-namespace", "test-service-account", map[string]string{})
+	require.NoError(t, err)
+
+	rendered, err := bundle.YAML()
+	require.NoError(t, err)
+	assert.Contains(t, rendered, "kind: ServiceAccount")
+	assert.Contains(t, rendered, "---")
+}
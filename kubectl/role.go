@@ -0,0 +1,137 @@
+package kubectl
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// PrepareRole will create a Kubernetes Role struct that can be used with CreateRole to create a new namespaced Role
+// resource on the Kubernetes cluster.
+func PrepareRole(
+	namespace string,
+	name string,
+	labels map[string]string,
+	annotations map[string]string,
+	rules []rbacv1.PolicyRule,
+) *rbacv1.Role {
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Rules: rules,
+	}
+}
+
+// CreateRole will create the given Role resource on the Kubernetes cluster.
+func CreateRole(kubectlOptions *KubectlOptions, role *rbacv1.Role) error {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return err
+	}
+	_, err = client.RbacV1().Roles(role.Namespace).Create(context.Background(), role, metav1.CreateOptions{})
+	return err
+}
+
+// GetRole will return the Role resource identified by the given namespace and name.
+func GetRole(kubectlOptions *KubectlOptions, namespace string, name string) (*rbacv1.Role, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return nil, err
+	}
+	return client.RbacV1().Roles(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
+// DeleteRole will delete the Role resource identified by the given namespace and name.
+func DeleteRole(kubectlOptions *KubectlOptions, namespace string, name string) error {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return err
+	}
+	return client.RbacV1().Roles(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+}
+
+// ListRoles will return the list of Role resources in the given namespace that match the provided list options.
+func ListRoles(kubectlOptions *KubectlOptions, namespace string, listOptions metav1.ListOptions) ([]rbacv1.Role, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return nil, err
+	}
+	roleList, err := client.RbacV1().Roles(namespace).List(context.Background(), listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return roleList.Items, nil
+}
+
+// LabelsToListOptions converts a map of labels to a metav1.ListOptions struct that can be used with the List
+// functions in this package to filter resources by label selector.
+func LabelsToListOptions(labels map[string]string) metav1.ListOptions {
+	return metav1.ListOptions{LabelSelector: labels2Selector(labels)}
+}
+
+// PrepareRoleBinding will create a Kubernetes RoleBinding struct that can be used with CreateRoleBinding to create a
+// new namespaced RoleBinding resource on the Kubernetes cluster.
+func PrepareRoleBinding(
+	namespace string,
+	name string,
+	labels map[string]string,
+	annotations map[string]string,
+	subjects []rbacv1.Subject,
+	roleRef rbacv1.RoleRef,
+) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Subjects: subjects,
+		RoleRef:  roleRef,
+	}
+}
+
+// CreateRoleBinding will create the given RoleBinding resource on the Kubernetes cluster.
+func CreateRoleBinding(kubectlOptions *KubectlOptions, roleBinding *rbacv1.RoleBinding) error {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return err
+	}
+	_, err = client.RbacV1().RoleBindings(roleBinding.Namespace).Create(context.Background(), roleBinding, metav1.CreateOptions{})
+	return err
+}
+
+// GetRoleBinding will return the RoleBinding resource identified by the given namespace and name.
+func GetRoleBinding(kubectlOptions *KubectlOptions, namespace string, name string) (*rbacv1.RoleBinding, error) {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return nil, err
+	}
+	return client.RbacV1().RoleBindings(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
+// DeleteRoleBinding will delete the RoleBinding resource identified by the given namespace and name.
+func DeleteRoleBinding(kubectlOptions *KubectlOptions, namespace string, name string) error {
+	client, err := GetKubernetesClientFromOptions(kubectlOptions)
+	if err != nil {
+		return err
+	}
+	return client.RbacV1().RoleBindings(namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+}
+
+// labels2Selector converts a map of labels into the string format expected by a Kubernetes label selector.
+func labels2Selector(labels map[string]string) string {
+	selector := ""
+	for k, v := range labels {
+		if selector != "" {
+			selector += ","
+		}
+		selector += k + "=" + v
+	}
+	return selector
+}
@@ -0,0 +1,18 @@
+package kubectl
+
+import (
+	"testing"
+
+	"github.com/tnn-gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/require"
+)
+
+// GetKubectlOptions returns a terratest KubectlOptions object alongside the equivalent KubectlOptions object used by
+// this package, both pointed at the kubeconfig context configured for the current test environment.
+func GetKubectlOptions(t *testing.T) (*k8s.KubectlOptions, *KubectlOptions) {
+	ttKubectlOptions := k8s.NewKubectlOptions("", "", "")
+	configPath, err := k8s.KubeConfigPathFromHomeDirE()
+	require.NoError(t, err)
+	kubectlOptions := &KubectlOptions{ConfigPath: configPath}
+	return ttKubectlOptions, kubectlOptions
+}